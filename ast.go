@@ -0,0 +1,146 @@
+package railgen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// inspectTestFile parses filePath and classifies the implementation status
+// of the function named testName: unimplemented when its body still has a
+// top-level `t.Skip(...)` statement, partial when that outer skip is gone but one or
+// more `t.Run` subtests still call `t.Skip`, and implemented otherwise. A
+// missing file is reported as unimplemented with no subtests.
+func inspectTestFile(fsys afero.Fs, filePath, testName string) (ImplStatus, []SubtestStatus, error) {
+	exists, err := afero.Exists(fsys, filePath)
+	if err != nil {
+		return StatusUnimplemented, nil, fmt.Errorf("failed to stat %s: %w", filePath, err)
+	}
+	if !exists {
+		return StatusUnimplemented, nil, nil
+	}
+
+	src, err := afero.ReadFile(fsys, filePath)
+	if err != nil {
+		return StatusUnimplemented, nil, fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filePath, src, parser.ParseComments)
+	if err != nil {
+		return StatusUnimplemented, nil, fmt.Errorf("failed to parse %s: %w", filePath, err)
+	}
+
+	var fn *ast.FuncDecl
+	for _, decl := range file.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Name.Name == testName {
+			fn = fd
+			break
+		}
+	}
+
+	if fn == nil || fn.Body == nil {
+		return StatusUnimplemented, nil, nil
+	}
+
+	outerSkipped := false
+	var subtests []SubtestStatus
+
+	for _, stmt := range fn.Body.List {
+		exprStmt, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+
+		call, ok := exprStmt.X.(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+
+		if isSkipCall(call) {
+			outerSkipped = true
+			continue
+		}
+
+		name, skipped, ok := runSubtestCall(call)
+		if !ok {
+			continue
+		}
+
+		subtests = append(subtests, SubtestStatus{
+			Name:        name,
+			Code:        strings.SplitN(name, "_", 2)[0],
+			Implemented: !outerSkipped && !skipped,
+		})
+	}
+
+	switch {
+	case outerSkipped:
+		return StatusUnimplemented, subtests, nil
+	case anySubtestSkipped(subtests):
+		return StatusPartial, subtests, nil
+	default:
+		return StatusImplemented, subtests, nil
+	}
+}
+
+func anySubtestSkipped(subtests []SubtestStatus) bool {
+	for _, st := range subtests {
+		if !st.Implemented {
+			return true
+		}
+	}
+	return false
+}
+
+// isSkipCall reports whether call is `<ident>.Skip(...)`, the shape the
+// template writes for both the outer `t.Skip` and nested subtest skips.
+func isSkipCall(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	return sel.Sel.Name == "Skip"
+}
+
+// runSubtestCall reports whether call is `<ident>.Run("name", func(t
+// *testing.T) {...})` and, if so, returns the subtest name and whether its
+// body still calls t.Skip at the top level.
+func runSubtestCall(call *ast.CallExpr) (name string, skipped bool, ok bool) {
+	sel, isSel := call.Fun.(*ast.SelectorExpr)
+	if !isSel || sel.Sel.Name != "Run" || len(call.Args) != 2 {
+		return "", false, false
+	}
+
+	lit, isLit := call.Args[0].(*ast.BasicLit)
+	if !isLit || lit.Kind != token.STRING {
+		return "", false, false
+	}
+
+	unquoted, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false, false
+	}
+
+	fn, isFn := call.Args[1].(*ast.FuncLit)
+	if !isFn || fn.Body == nil {
+		return unquoted, false, true
+	}
+
+	for _, stmt := range fn.Body.List {
+		exprStmt, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+		if innerCall, ok := exprStmt.X.(*ast.CallExpr); ok && isSkipCall(innerCall) {
+			return unquoted, true, true
+		}
+	}
+
+	return unquoted, false, true
+}
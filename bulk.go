@@ -0,0 +1,117 @@
+package railgen
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// GenerateResult is the outcome of generating a single operation's test
+// file as part of a bulk GenerateMany run.
+type GenerateResult struct {
+	OperationID string
+	Err         error
+}
+
+// ResolveOperationIDs expands patterns (exact operation IDs or
+// filepath.Match globs, e.g. "user*") and tags (exact OpenAPI tag matches)
+// into the deduplicated, sorted set of operation IDs in the spec that
+// either selector matches.
+func (g *Generator) ResolveOperationIDs(patterns, tags []string) ([]string, error) {
+	doc, err := g.loadSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	tagSet := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		tagSet[t] = true
+	}
+
+	seen := make(map[string]bool)
+	var ids []string
+	for _, pathItem := range doc.Paths.Map() {
+		for _, op := range pathItem.Operations() {
+			if op.OperationID == "" || seen[op.OperationID] {
+				continue
+			}
+
+			matched, err := matchesOperation(op, patterns, tagSet)
+			if err != nil {
+				return nil, err
+			}
+
+			if matched {
+				seen[op.OperationID] = true
+				ids = append(ids, op.OperationID)
+			}
+		}
+	}
+
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func matchesOperation(op *openapi3.Operation, patterns []string, tagSet map[string]bool) (bool, error) {
+	for _, tag := range op.Tags {
+		if tagSet[tag] {
+			return true, nil
+		}
+	}
+
+	for _, pattern := range patterns {
+		ok, err := filepath.Match(pattern, op.OperationID)
+		if err != nil {
+			return false, fmt.Errorf("invalid operation selector %q: %w", pattern, err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GenerateMany runs GenerateTest for each operation ID across a bounded
+// pool of workers (at least 1), collecting a result per operation instead
+// of aborting the whole run on the first failure.
+func (g *Generator) GenerateMany(operationIDs []string, commentsFile string, overwrite, force bool, workers int) []GenerateResult {
+	if workers < 1 {
+		workers = 1
+	}
+
+	type job struct {
+		index int
+		id    string
+	}
+
+	jobs := make(chan job)
+	results := make([]GenerateResult, len(operationIDs))
+
+	go func() {
+		defer close(jobs)
+		for i, id := range operationIDs {
+			jobs <- job{index: i, id: id}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results[j.index] = GenerateResult{
+					OperationID: j.id,
+					Err:         g.GenerateTest(j.id, commentsFile, overwrite, force),
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
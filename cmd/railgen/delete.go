@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newDeleteCmd(flags *globalFlags) *cobra.Command {
+	var operationID string
+
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete test files for an OpenAPI operation ID",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if operationID == "" {
+				return fmt.Errorf("operation ID is required")
+			}
+
+			gen, err := newGenerator(flags)
+			if err != nil {
+				return err
+			}
+
+			return gen.DeleteTest(operationID)
+		},
+	}
+
+	cmd.Flags().StringVarP(&operationID, "operation", "o", "", "Operation ID to delete test for")
+
+	return cmd
+}
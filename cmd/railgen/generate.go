@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/spf13/cobra"
+)
+
+func newGenerateCmd(flags *globalFlags) *cobra.Command {
+	var operationSelectors []string
+	var tagSelectors []string
+	var commentsFile string
+	var overwrite bool
+	var force bool
+	var workers int
+	var templatePath string
+	var templateDir string
+
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate test files from OpenAPI operation IDs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(operationSelectors) == 0 && len(tagSelectors) == 0 {
+				return fmt.Errorf("at least one -o selector or --tag is required")
+			}
+
+			gen, err := newGenerator(flags)
+			if err != nil {
+				return err
+			}
+			gen.TemplatePath = templatePath
+			gen.TemplateDir = templateDir
+
+			ids, err := gen.ResolveOperationIDs(operationSelectors, tagSelectors)
+			if err != nil {
+				return err
+			}
+			if len(ids) == 0 {
+				return fmt.Errorf("no operations matched the given -o/--tag selectors")
+			}
+
+			results := gen.GenerateMany(ids, commentsFile, overwrite, force, workers)
+			return printGenerateSummary(results)
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&operationSelectors, "operation", "o", nil, "Operation ID, glob pattern (e.g. 'user*'), or repeated -o flag")
+	cmd.Flags().StringArrayVar(&tagSelectors, "tag", nil, "Select all operations under this OpenAPI tag (repeatable)")
+	cmd.Flags().StringVarP(&commentsFile, "comments", "c", "", "Comments file to include custom TODO comments")
+	cmd.Flags().BoolVar(&overwrite, "overwrite", false, "Overwrite existing test file (backs it up unless it's clean in git)")
+	cmd.Flags().BoolVar(&force, "force", false, "Overwrite even if the existing file has uncommitted git changes")
+	cmd.Flags().IntVarP(&workers, "jobs", "j", runtime.NumCPU(), "Number of parallel generation workers")
+	cmd.Flags().StringVar(&templatePath, "template", "", "Path to a custom text/template file overriding the built-in test template")
+	cmd.Flags().StringVar(&templateDir, "template-dir", "", "Directory of per-tag template overrides, resolved as <dir>/<package-name>.go.tmpl")
+
+	return cmd
+}
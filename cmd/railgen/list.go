@@ -0,0 +1,40 @@
+package main
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func newListCmd(flags *globalFlags) *cobra.Command {
+	var unimplementedOnly bool
+	var jsonOutput bool
+	var blame bool
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List operation IDs and their implementation status",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gen, err := newGenerator(flags)
+			if err != nil {
+				return err
+			}
+
+			operations, err := gen.ListOperations(blame)
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				return printReportJSON(operations, unimplementedOnly)
+			}
+
+			printReportText(operations, unimplementedOnly)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&unimplementedOnly, "unimplemented", false, "Show only unimplemented operation IDs")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Print the report as JSON instead of plain text")
+	cmd.Flags().BoolVar(&blame, "blame", false, "Show the last commit (SHA, author, date) that touched each partially or fully implemented test file")
+
+	return cmd
+}
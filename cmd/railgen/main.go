@@ -0,0 +1,10 @@
+// Command railgen generates Go test rails from an OpenAPI specification.
+package main
+
+import "os"
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
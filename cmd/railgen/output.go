@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/otakakot/railgen"
+)
+
+func printGenerateSummary(results []railgen.GenerateResult) error {
+	var failed []railgen.GenerateResult
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+
+	fmt.Println()
+	fmt.Println("Generate Summary:")
+	fmt.Println("=================")
+	for _, result := range failed {
+		fmt.Fprintf(os.Stderr, "FAILED %s: %v\n", result.OperationID, result.Err)
+	}
+	fmt.Printf("%d/%d operations generated successfully\n", len(results)-len(failed), len(results))
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d/%d operations failed to generate", len(failed), len(results))
+	}
+	return nil
+}
+
+func statusMarker(status string) string {
+	switch status {
+	case "implemented":
+		return "[x]"
+	case "partial":
+		return "[~]"
+	default:
+		return "[ ]"
+	}
+}
+
+func blameSuffix(info *railgen.BlameInfo) string {
+	if info == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (%s %s %s)", info.ShortSHA, info.Author, info.When.Format("2006-01-02"))
+}
+
+func printSubtests(subtests []railgen.SubtestStatus, indent string) {
+	for _, st := range subtests {
+		marker := "[ ]"
+		if st.Implemented {
+			marker = "[x]"
+		}
+		fmt.Printf("%s%s %s\n", indent, marker, st.Code)
+	}
+}
+
+func printReportJSON(operations []railgen.OperationInfo, unimplementedOnly bool) error {
+	report := railgen.BuildReport(operations, unimplementedOnly)
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
+
+func printReportText(operations []railgen.OperationInfo, unimplementedOnly bool) {
+	report := railgen.BuildReport(operations, unimplementedOnly)
+
+	if unimplementedOnly {
+		fmt.Println("Unimplemented Operation IDs:")
+		fmt.Println("============================")
+		currentTag := ""
+		for _, op := range report.Operations {
+			if op.Tag != currentTag {
+				if currentTag != "" {
+					fmt.Println()
+				}
+				fmt.Printf("[%s]\n", op.Tag)
+				fmt.Println(strings.Repeat("-", len(op.Tag)+2))
+				currentTag = op.Tag
+			}
+
+			fmt.Printf("* %s %s%s\n", statusMarker(op.Status), op.ID, blameSuffix(op.Blame))
+			fmt.Printf("  %s %s\n", op.Method, op.Path)
+			printSubtests(op.Subtests, "  ")
+			fmt.Println()
+		}
+		if len(report.Operations) == 0 {
+			fmt.Println("All operations have been implemented!")
+		} else {
+			fmt.Printf("Total unimplemented: %d\n", len(report.Operations))
+		}
+		return
+	}
+
+	fmt.Println("All Operation IDs:")
+	fmt.Println("==================")
+	currentTag := ""
+	for _, op := range report.Operations {
+		if op.Tag != currentTag {
+			if currentTag != "" {
+				fmt.Println()
+			}
+			fmt.Printf("[%s]\n", op.Tag)
+			fmt.Println(strings.Repeat("-", len(op.Tag)+2))
+			currentTag = op.Tag
+		}
+
+		fmt.Printf("%s %s%s\n", statusMarker(op.Status), op.ID, blameSuffix(op.Blame))
+		fmt.Printf("    %s %s\n", op.Method, op.Path)
+		printSubtests(op.Subtests, "    ")
+		fmt.Println()
+	}
+
+	fmt.Printf("Implementation Status: %d/%d files (%.1f%%)\n",
+		report.Summary.FilesImplemented, report.Summary.FilesTotal, report.Summary.FilesPercent)
+	if report.Summary.SubtestsTotal > 0 {
+		fmt.Printf("Subtest Status: %d/%d subtests (%.1f%%)\n",
+			report.Summary.SubtestsImplemented, report.Summary.SubtestsTotal, report.Summary.SubtestsPercent)
+	}
+}
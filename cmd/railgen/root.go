@@ -0,0 +1,59 @@
+package main
+
+import (
+	"runtime/debug"
+
+	"github.com/otakakot/railgen"
+	"github.com/spf13/cobra"
+)
+
+var version string
+
+// globalFlags holds the persistent flags shared by every subcommand.
+type globalFlags struct {
+	openapiFile string
+	outputDir   string
+	fsTarget    string
+}
+
+func newRootCmd() *cobra.Command {
+	flags := &globalFlags{}
+
+	root := &cobra.Command{
+		Use:           "railgen",
+		Short:         "Generate test rails from an OpenAPI specification",
+		Long:          "railgen is a CLI tool to generate Go test files from OpenAPI operation IDs.",
+		Version:       resolveVersion(),
+		SilenceUsage:  true,
+		SilenceErrors: false,
+	}
+
+	root.PersistentFlags().StringVarP(&flags.openapiFile, "file", "f", "openapi.yaml", "OpenAPI specification file")
+	root.PersistentFlags().StringVarP(&flags.outputDir, "output", "d", "test", "Output directory for generated tests")
+	root.PersistentFlags().StringVar(&flags.fsTarget, "fs", "", "Filesystem backend for --file/--output (local disk, http(s)://, or s3://bucket/prefix)")
+
+	root.AddCommand(newGenerateCmd(flags))
+	root.AddCommand(newDeleteCmd(flags))
+	root.AddCommand(newListCmd(flags))
+	root.AddCommand(newTemplateCmd())
+
+	return root
+}
+
+func resolveVersion() string {
+	if version != "" {
+		return version
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		return bi.Main.Version
+	}
+	return "dev"
+}
+
+func newGenerator(flags *globalFlags) (*railgen.Generator, error) {
+	fsys, err := railgen.ResolveFs(flags.fsTarget)
+	if err != nil {
+		return nil, err
+	}
+	return railgen.NewGenerator(fsys, flags.openapiFile, flags.outputDir), nil
+}
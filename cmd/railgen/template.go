@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/otakakot/railgen"
+	"github.com/spf13/cobra"
+)
+
+func newTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Inspect and manage the test generation template",
+	}
+
+	cmd.AddCommand(newTemplateDumpCmd())
+
+	return cmd
+}
+
+func newTemplateDumpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump [path]",
+		Short: "Write the built-in template to disk as a starting point for customization",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outPath := "railgen.go.tmpl"
+			if len(args) == 1 {
+				outPath = args[0]
+			}
+
+			src, err := railgen.DefaultTemplate()
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(outPath, src, 0o644); err != nil {
+				return fmt.Errorf("failed to write template to %s: %w", outPath, err)
+			}
+
+			fmt.Printf("Wrote built-in template to %s\n", outPath)
+			return nil
+		},
+	}
+
+	return cmd
+}
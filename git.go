@@ -0,0 +1,126 @@
+package railgen
+
+import (
+	"errors"
+	"path/filepath"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/spf13/afero"
+)
+
+// GitStatus describes whether a file sits inside a git working tree and,
+// if so, whether it currently has uncommitted changes.
+type GitStatus struct {
+	InRepo bool
+	Dirty  bool
+}
+
+// BlameInfo is the last commit that touched a file, surfaced by `list
+// --blame`.
+type BlameInfo struct {
+	ShortSHA string    `json:"sha"`
+	Author   string    `json:"author"`
+	When     time.Time `json:"when"`
+}
+
+// gitStatus reports whether filePath lives inside a git working tree and,
+// if so, whether it has uncommitted changes. Git awareness only applies to
+// the local OS filesystem; remote/in-memory backends always report
+// InRepo: false so callers fall back to the non-git behavior.
+func gitStatus(fsys afero.Fs, filePath string) (GitStatus, error) {
+	if _, ok := fsys.(*afero.OsFs); !ok {
+		return GitStatus{}, nil
+	}
+
+	repo, rel, err := openRepoFor(filePath)
+	if err != nil {
+		if errors.Is(err, git.ErrRepositoryNotExists) {
+			return GitStatus{}, nil
+		}
+		return GitStatus{}, err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return GitStatus{}, err
+	}
+
+	status, err := wt.Status()
+	if err != nil {
+		return GitStatus{}, err
+	}
+
+	// An untracked file (never committed) isn't "dirty" in the sense we
+	// care about here: there's nothing to lose by overwriting it, so it
+	// takes the same no-backup path as a clean, committed file.
+	fileStatus := status.File(rel)
+	dirty := (fileStatus.Worktree != git.Unmodified && fileStatus.Worktree != git.Untracked) ||
+		(fileStatus.Staging != git.Unmodified && fileStatus.Staging != git.Untracked)
+
+	return GitStatus{InRepo: true, Dirty: dirty}, nil
+}
+
+// gitBlame returns the last commit that touched filePath, or nil if the
+// file isn't tracked in a git repository (or has no history yet).
+func gitBlame(fsys afero.Fs, filePath string) (*BlameInfo, error) {
+	if _, ok := fsys.(*afero.OsFs); !ok {
+		return nil, nil
+	}
+
+	repo, rel, err := openRepoFor(filePath)
+	if err != nil {
+		if errors.Is(err, git.ErrRepositoryNotExists) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, nil
+	}
+
+	commits, err := repo.Log(&git.LogOptions{From: head.Hash(), FileName: &rel})
+	if err != nil {
+		return nil, err
+	}
+	defer commits.Close()
+
+	commit, err := commits.Next()
+	if err != nil {
+		return nil, nil
+	}
+
+	return &BlameInfo{
+		ShortSHA: commit.Hash.String()[:7],
+		Author:   commit.Author.Name,
+		When:     commit.Author.When,
+	}, nil
+}
+
+// openRepoFor opens the git repository containing filePath and returns it
+// along with filePath's path relative to the worktree root.
+func openRepoFor(filePath string) (*git.Repository, string, error) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	repo, err := git.PlainOpenWithOptions(filepath.Dir(absPath), &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, "", err
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return nil, "", err
+	}
+
+	rel, err := filepath.Rel(wt.Filesystem.Root(), absPath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return repo, filepath.ToSlash(rel), nil
+}
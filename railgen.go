@@ -0,0 +1,323 @@
+// Package railgen generates Go test rails from an OpenAPI specification.
+package railgen
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/spf13/afero"
+)
+
+// Generator scaffolds and inspects OpenAPI-derived test files. All file
+// access goes through Fs, which defaults to the local OS filesystem but can
+// be swapped for an in-memory or remote backend (see NewGenerator).
+type Generator struct {
+	Fs          afero.Fs
+	OpenAPIFile string
+	OutputDir   string
+
+	// TemplatePath, if set, overrides the embedded default template for
+	// every generated test file.
+	TemplatePath string
+	// TemplateDir, if set, is checked first for a per-tag override named
+	// "<packageName>.go.tmpl" before falling back to TemplatePath/default.
+	TemplateDir string
+}
+
+// NewGenerator builds a Generator that reads the OpenAPI spec from
+// openapiFile and reads/writes generated tests under outputDir on fsys. A
+// nil fsys defaults to afero.NewOsFs().
+func NewGenerator(fsys afero.Fs, openapiFile, outputDir string) *Generator {
+	if fsys == nil {
+		fsys = afero.NewOsFs()
+	}
+	return &Generator{Fs: fsys, OpenAPIFile: openapiFile, OutputDir: outputDir}
+}
+
+func (g *Generator) loadSpec() (*openapi3.T, error) {
+	data, err := afero.ReadFile(g.Fs, g.OpenAPIFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI file: %w", err)
+	}
+
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI file: %w", err)
+	}
+
+	return doc, nil
+}
+
+// GenerateTest renders the test file for operationID, optionally folding in
+// custom TODO comments loaded from commentsFile. If the target file already
+// exists, it is left untouched unless overwrite is set. When the existing
+// file lives in a clean git working tree, it is overwritten in place with
+// no backup; when it has uncommitted changes, it is refused unless force is
+// set, and then backed up to a timestamped sidecar before being overwritten;
+// outside a git repo it always gets that timestamped sidecar backup.
+func (g *Generator) GenerateTest(operationID, commentsFile string, overwrite, force bool) error {
+	doc, err := g.loadSpec()
+	if err != nil {
+		return err
+	}
+
+	var operation *openapi3.Operation
+	var tag string
+	var method string
+	var path string
+	var found bool
+
+	for apiPath, pathItem := range doc.Paths.Map() {
+		for httpMethod, op := range pathItem.Operations() {
+			if op.OperationID == operationID {
+				operation = op
+				method = strings.ToUpper(httpMethod)
+				path = apiPath
+				found = true
+				if len(op.Tags) > 0 {
+					tag = op.Tags[0]
+				}
+				fmt.Printf("Found operation %s %s with operationId: %s\n", method, path, operationID)
+				break
+			}
+		}
+		if found {
+			break
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("operation with ID '%s' not found", operationID)
+	}
+
+	customComments, err := loadCustomComment(g.Fs, commentsFile)
+	if err != nil {
+		return fmt.Errorf("failed to load custom comment: %w", err)
+	}
+
+	testData := TestData{
+		PackageName:    sanitizePackageName(tag),
+		TestName:       "Test" + toPascalCase(operationID),
+		Method:         method,
+		Path:           path,
+		Summary:        operation.Summary,
+		Description:    operation.Description,
+		Responses:      []ResponseCode{},
+		CustomComments: customComments,
+		Parameters:     buildParameters(operation),
+		RequestBody:    buildRequestBody(operation),
+		Security:       buildSecurity(operation),
+	}
+
+	var codes []string
+	for code := range operation.Responses.Map() {
+		if code != "default" {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		response := operation.Responses.Map()[code]
+		description := ""
+		if response.Value != nil && response.Value.Description != nil {
+			description = *response.Value.Description
+		}
+		testData.Responses = append(testData.Responses, ResponseCode{
+			Code:        code,
+			Description: description,
+			Method:      method,
+			Path:        path,
+			Contents:    buildResponseContents(response),
+		})
+	}
+
+	tagDir := filepath.Join(g.OutputDir, testData.PackageName)
+	if err := g.Fs.MkdirAll(tagDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	tmpl, err := g.loadTemplate(testData.PackageName)
+	if err != nil {
+		return err
+	}
+
+	fileName := fmt.Sprintf("%s_test.go", toSnakeCase(operationID))
+	filePath := filepath.Join(tagDir, fileName)
+
+	if exists, err := afero.Exists(g.Fs, filePath); err != nil {
+		return fmt.Errorf("failed to stat %s: %w", filePath, err)
+	} else if exists {
+		if !overwrite {
+			return fmt.Errorf("test file already exists: %s\nUse --overwrite to overwrite the existing file", filePath)
+		}
+
+		status, err := gitStatus(g.Fs, filePath)
+		if err != nil {
+			return fmt.Errorf("failed to check git status of %s: %w", filePath, err)
+		}
+
+		switch {
+		case !status.InRepo:
+			backupPath := fmt.Sprintf("%s.backup.%s", filePath, time.Now().Format("20060102-150405"))
+			if err := copyFile(g.Fs, filePath, backupPath); err != nil {
+				return fmt.Errorf("failed to create backup: %w", err)
+			}
+			fmt.Printf("Created backup: %s\n", backupPath)
+		case status.Dirty && !force:
+			return fmt.Errorf("refusing to overwrite %s: it has uncommitted changes (use --force to overwrite anyway)", filePath)
+		case status.Dirty:
+			backupPath := fmt.Sprintf("%s.backup.%s", filePath, time.Now().Format("20060102-150405"))
+			if err := copyFile(g.Fs, filePath, backupPath); err != nil {
+				return fmt.Errorf("failed to create backup: %w", err)
+			}
+			fmt.Printf("Created backup: %s\n", backupPath)
+			fmt.Printf("Overwriting %s despite uncommitted changes (--force)\n", filePath)
+		default:
+			fmt.Printf("Overwriting %s (clean in git, no backup needed)\n", filePath)
+		}
+	}
+
+	file, err := g.Fs.Create(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create test file: %w", err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to close file: %v\n", err)
+		}
+	}()
+
+	if err := tmpl.Execute(file, testData); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	fmt.Printf("Generated test file: %s\n", filePath)
+	return nil
+}
+
+// DeleteTest removes the generated test file for operationID, and the tag
+// directory along with it if that leaves the directory empty.
+func (g *Generator) DeleteTest(operationID string) error {
+	doc, err := g.loadSpec()
+	if err != nil {
+		return err
+	}
+
+	var tag string
+	var found bool
+
+	for _, pathItem := range doc.Paths.Map() {
+		for _, op := range pathItem.Operations() {
+			if op.OperationID == operationID {
+				found = true
+				if len(op.Tags) > 0 {
+					tag = op.Tags[0]
+				}
+				break
+			}
+		}
+		if found {
+			break
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("operation with ID '%s' not found", operationID)
+	}
+
+	packageName := sanitizePackageName(tag)
+	tagDir := filepath.Join(g.OutputDir, packageName)
+	fileName := fmt.Sprintf("%s_test.go", toSnakeCase(operationID))
+	filePath := filepath.Join(tagDir, fileName)
+
+	if exists, err := afero.Exists(g.Fs, filePath); err != nil {
+		return fmt.Errorf("failed to stat %s: %w", filePath, err)
+	} else if !exists {
+		return fmt.Errorf("test file does not exist: %s", filePath)
+	}
+
+	if err := g.Fs.Remove(filePath); err != nil {
+		return fmt.Errorf("failed to delete test file: %w", err)
+	}
+
+	fmt.Printf("Deleted test file: %s\n", filePath)
+
+	if err := g.Fs.Remove(tagDir); err == nil {
+		fmt.Printf("Removed empty directory: %s\n", tagDir)
+	}
+
+	return nil
+}
+
+// ListOperations returns every operation in the spec together with the
+// implementation status of its generated test file, sorted by tag then ID.
+// When blame is set, each partially or fully implemented operation is
+// annotated with the last git commit that touched its test file (nil outside
+// a git repo); unimplemented operations get no blame info.
+func (g *Generator) ListOperations(blame bool) ([]OperationInfo, error) {
+	doc, err := g.loadSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	var operations []OperationInfo
+	for apiPath, pathItem := range doc.Paths.Map() {
+		for httpMethod, op := range pathItem.Operations() {
+			if op.OperationID == "" {
+				continue
+			}
+
+			tag := ""
+			if len(op.Tags) > 0 {
+				tag = op.Tags[0]
+			}
+
+			operations = append(operations, OperationInfo{
+				ID:          op.OperationID,
+				Method:      strings.ToUpper(httpMethod),
+				Path:        apiPath,
+				Tag:         tag,
+				Summary:     op.Summary,
+				Description: op.Description,
+			})
+		}
+	}
+
+	for i := range operations {
+		op := &operations[i]
+		packageName := sanitizePackageName(op.Tag)
+		fileName := fmt.Sprintf("%s_test.go", toSnakeCase(op.ID))
+		filePath := filepath.Join(g.OutputDir, packageName, fileName)
+		testName := "Test" + toPascalCase(op.ID)
+
+		status, subtests, err := inspectTestFile(g.Fs, filePath, testName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect %s: %w", filePath, err)
+		}
+		op.Status = status
+		op.Subtests = subtests
+
+		if blame && status != StatusUnimplemented {
+			info, err := gitBlame(g.Fs, filePath)
+			if err != nil {
+				return nil, fmt.Errorf("failed to blame %s: %w", filePath, err)
+			}
+			op.Blame = info
+		}
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].Tag != operations[j].Tag {
+			return operations[i].Tag < operations[j].Tag
+		}
+		return operations[i].ID < operations[j].ID
+	})
+
+	return operations, nil
+}
@@ -0,0 +1,148 @@
+package railgen
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+const testSpec = `{
+	"openapi": "3.0.0",
+	"info": {"title": "widgets", "version": "1.0"},
+	"paths": {
+		"/widgets": {
+			"get": {
+				"operationId": "listWidgets",
+				"tags": ["widgets"],
+				"responses": {
+					"200": {"description": "ok"}
+				}
+			}
+		}
+	}
+}`
+
+func newTestGenerator(t *testing.T) *Generator {
+	t.Helper()
+
+	fs := afero.NewMemMapFs()
+	if err := afero.WriteFile(fs, "openapi.json", []byte(testSpec), 0o644); err != nil {
+		t.Fatalf("failed to seed openapi.json: %v", err)
+	}
+
+	return NewGenerator(fs, "openapi.json", "test")
+}
+
+func TestGenerateTestCreatesFile(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	if err := gen.GenerateTest("listWidgets", "", false, false); err != nil {
+		t.Fatalf("GenerateTest returned an error: %v", err)
+	}
+
+	exists, err := afero.Exists(gen.Fs, "test/widgets/list_widgets_test.go")
+	if err != nil {
+		t.Fatalf("failed to stat generated file: %v", err)
+	}
+	if !exists {
+		t.Fatal("expected test/widgets/list_widgets_test.go to be created")
+	}
+}
+
+func TestGenerateTestRefusesOverwriteWithoutFlag(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	if err := gen.GenerateTest("listWidgets", "", false, false); err != nil {
+		t.Fatalf("GenerateTest returned an error: %v", err)
+	}
+
+	err := gen.GenerateTest("listWidgets", "", false, false)
+	if err == nil {
+		t.Fatal("expected GenerateTest to refuse to overwrite an existing file without --overwrite")
+	}
+	if !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected an 'already exists' error, got: %v", err)
+	}
+}
+
+func TestGenerateTestUnknownOperationID(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	if err := gen.GenerateTest("noSuchOperation", "", false, false); err == nil {
+		t.Fatal("expected an error for an unknown operation ID")
+	}
+}
+
+func TestListOperationsUnimplementedByDefault(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	if err := gen.GenerateTest("listWidgets", "", false, false); err != nil {
+		t.Fatalf("GenerateTest returned an error: %v", err)
+	}
+
+	operations, err := gen.ListOperations(false)
+	if err != nil {
+		t.Fatalf("ListOperations returned an error: %v", err)
+	}
+	if len(operations) != 1 {
+		t.Fatalf("expected 1 operation, got %d", len(operations))
+	}
+	if operations[0].Status != StatusUnimplemented {
+		t.Fatalf("expected status %s, got %s", StatusUnimplemented, operations[0].Status)
+	}
+}
+
+func TestListOperationsImplementedAfterSkipRemoved(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	if err := gen.GenerateTest("listWidgets", "", false, false); err != nil {
+		t.Fatalf("GenerateTest returned an error: %v", err)
+	}
+
+	const filePath = "test/widgets/list_widgets_test.go"
+	src, err := afero.ReadFile(gen.Fs, filePath)
+	if err != nil {
+		t.Fatalf("failed to read generated file: %v", err)
+	}
+
+	implemented := strings.ReplaceAll(string(src), `t.Skip("not implemented")`, "_ = t")
+	if err := afero.WriteFile(gen.Fs, filePath, []byte(implemented), 0o644); err != nil {
+		t.Fatalf("failed to rewrite generated file: %v", err)
+	}
+
+	operations, err := gen.ListOperations(false)
+	if err != nil {
+		t.Fatalf("ListOperations returned an error: %v", err)
+	}
+	if operations[0].Status != StatusImplemented {
+		t.Fatalf("expected status %s, got %s", StatusImplemented, operations[0].Status)
+	}
+	if len(operations[0].Subtests) != 1 || !operations[0].Subtests[0].Implemented {
+		t.Fatalf("expected the 200 subtest to be implemented, got %+v", operations[0].Subtests)
+	}
+}
+
+func TestDeleteTestRemovesFileAndEmptyDir(t *testing.T) {
+	gen := newTestGenerator(t)
+
+	if err := gen.GenerateTest("listWidgets", "", false, false); err != nil {
+		t.Fatalf("GenerateTest returned an error: %v", err)
+	}
+
+	if err := gen.DeleteTest("listWidgets"); err != nil {
+		t.Fatalf("DeleteTest returned an error: %v", err)
+	}
+
+	exists, err := afero.Exists(gen.Fs, "test/widgets/list_widgets_test.go")
+	if err != nil {
+		t.Fatalf("failed to stat deleted file: %v", err)
+	}
+	if exists {
+		t.Fatal("expected list_widgets_test.go to be deleted")
+	}
+
+	if exists, _ := afero.DirExists(gen.Fs, "test/widgets"); exists {
+		t.Fatal("expected the now-empty tag directory to be removed")
+	}
+}
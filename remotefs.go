@@ -0,0 +1,291 @@
+package railgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/spf13/afero"
+)
+
+// ResolveFs turns a `-fs` flag value into the afero.Fs backend it names:
+// "" for the local OS filesystem, "http://" or "https://" for a spec served
+// over HTTP, and "s3://bucket/prefix" for specs and output stored in S3.
+func ResolveFs(target string) (afero.Fs, error) {
+	if target == "" {
+		return afero.NewOsFs(), nil
+	}
+
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse -fs target %q: %w", target, err)
+	}
+
+	switch u.Scheme {
+	case "", "file":
+		return afero.NewOsFs(), nil
+	case "http", "https":
+		base := *u
+		base.Path = ""
+		return newHTTPFs(&base), nil
+	case "s3":
+		return newS3Fs(context.Background(), u.Host, strings.TrimPrefix(u.Path, "/"))
+	default:
+		return nil, fmt.Errorf("unsupported -fs scheme %q", u.Scheme)
+	}
+}
+
+// httpFs is a minimal, read-only afero.Fs that serves file contents by
+// issuing a GET against base+name. It exists because afero's own HttpFs
+// goes the other direction (exposing an afero.Fs over HTTP); nothing in
+// afero fetches a remote file as a client, so this fetches into a small
+// in-memory cache and delegates reads to it.
+type httpFs struct {
+	client *http.Client
+	base   *url.URL
+	cache  afero.Fs
+}
+
+func newHTTPFs(base *url.URL) *httpFs {
+	return &httpFs{client: http.DefaultClient, base: base, cache: afero.NewMemMapFs()}
+}
+
+func (h *httpFs) fetch(name string) error {
+	if exists, _ := afero.Exists(h.cache, name); exists {
+		return nil
+	}
+
+	u := *h.base
+	u.Path = path.Join(u.Path, name)
+
+	resp, err := h.client.Get(u.String())
+	if err != nil {
+		return fmt.Errorf("httpfs: failed to fetch %s: %w", u.String(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("httpfs: %s returned status %s", u.String(), resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("httpfs: failed to read %s: %w", u.String(), err)
+	}
+
+	return afero.WriteFile(h.cache, name, body, 0o644)
+}
+
+func (h *httpFs) Open(name string) (afero.File, error) {
+	if err := h.fetch(name); err != nil {
+		return nil, err
+	}
+	return h.cache.Open(name)
+}
+
+func (h *httpFs) Stat(name string) (os.FileInfo, error) {
+	if err := h.fetch(name); err != nil {
+		return nil, err
+	}
+	return h.cache.Stat(name)
+}
+
+func (h *httpFs) Name() string { return "httpFs" }
+
+func (h *httpFs) notSupported(op string) error {
+	return fmt.Errorf("httpfs: %s is not supported against a read-only HTTP source", op)
+}
+
+func (h *httpFs) Create(name string) (afero.File, error)       { return nil, h.notSupported("Create") }
+func (h *httpFs) Mkdir(name string, perm os.FileMode) error    { return h.notSupported("Mkdir") }
+func (h *httpFs) MkdirAll(path string, perm os.FileMode) error { return h.notSupported("MkdirAll") }
+
+func (h *httpFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	if flag != os.O_RDONLY {
+		return nil, h.notSupported("OpenFile with write flags")
+	}
+	return h.Open(name)
+}
+
+func (h *httpFs) Remove(name string) error                  { return h.notSupported("Remove") }
+func (h *httpFs) RemoveAll(path string) error               { return h.notSupported("RemoveAll") }
+func (h *httpFs) Rename(oldname, newname string) error      { return h.notSupported("Rename") }
+func (h *httpFs) Chmod(name string, mode os.FileMode) error { return h.notSupported("Chmod") }
+func (h *httpFs) Chown(name string, uid, gid int) error     { return h.notSupported("Chown") }
+
+func (h *httpFs) Chtimes(name string, atime, mtime time.Time) error {
+	return h.notSupported("Chtimes")
+}
+
+// s3Fs is a small afero.Fs wrapper over an S3 bucket/prefix. It only
+// implements the operations generate/delete/list actually perform (Open,
+// Create, Stat, Remove); railgen never creates S3 "directories" or renames
+// objects, so those are harmless no-ops or explicit errors.
+type s3Fs struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+func newS3Fs(ctx context.Context, bucket, prefix string) (*s3Fs, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("s3fs: failed to load AWS config: %w", err)
+	}
+	return &s3Fs{client: s3.NewFromConfig(cfg), bucket: bucket, prefix: prefix}, nil
+}
+
+func (s *s3Fs) key(name string) string {
+	return path.Join(s.prefix, name)
+}
+
+func (s *s3Fs) Open(name string) (afero.File, error) {
+	out, err := s.client.GetObject(context.Background(), &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("s3fs: failed to get s3://%s/%s: %w", s.bucket, s.key(name), err)
+	}
+	defer out.Body.Close()
+
+	body, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("s3fs: failed to read s3://%s/%s: %w", s.bucket, s.key(name), err)
+	}
+
+	mem := afero.NewMemMapFs()
+	if err := afero.WriteFile(mem, name, body, 0o644); err != nil {
+		return nil, err
+	}
+	return mem.Open(name)
+}
+
+// Create returns an in-memory file that flushes itself to S3 as a single
+// PutObject on Close, since S3 has no append/seek-write semantics to stream
+// writes into directly.
+func (s *s3Fs) Create(name string) (afero.File, error) {
+	mem := afero.NewMemMapFs()
+	f, err := mem.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	return &s3WriteFile{File: f, fs: s, mem: mem, name: name}, nil
+}
+
+func (s *s3Fs) Stat(name string) (os.FileInfo, error) {
+	out, err := s.client.HeadObject(context.Background(), &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		var notFound *s3types.NotFound
+		if errors.As(err, &notFound) {
+			return nil, &os.PathError{Op: "stat", Path: name, Err: os.ErrNotExist}
+		}
+		return nil, fmt.Errorf("s3fs: failed to head s3://%s/%s: %w", s.bucket, s.key(name), err)
+	}
+
+	info := s3FileInfo{name: path.Base(name)}
+	if out.ContentLength != nil {
+		info.size = *out.ContentLength
+	}
+	if out.LastModified != nil {
+		info.modTime = *out.LastModified
+	}
+	return info, nil
+}
+
+func (s *s3Fs) Name() string { return "s3Fs" }
+
+func (s *s3Fs) Remove(name string) error {
+	_, err := s.client.DeleteObject(context.Background(), &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		return fmt.Errorf("s3fs: failed to delete s3://%s/%s: %w", s.bucket, s.key(name), err)
+	}
+	return nil
+}
+
+func (s *s3Fs) RemoveAll(path string) error { return s.Remove(path) }
+
+// MkdirAll and Mkdir are no-ops: S3 has no real directories, objects are
+// addressed by their full key regardless of "directory" prefixes.
+func (s *s3Fs) MkdirAll(path string, perm os.FileMode) error { return nil }
+func (s *s3Fs) Mkdir(name string, perm os.FileMode) error    { return nil }
+
+func (s *s3Fs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	if flag&(os.O_WRONLY|os.O_CREATE) != 0 {
+		return s.Create(name)
+	}
+	return s.Open(name)
+}
+
+func (s *s3Fs) Rename(oldname, newname string) error {
+	return fmt.Errorf("s3fs: Rename is not supported")
+}
+
+func (s *s3Fs) Chmod(name string, mode os.FileMode) error         { return nil }
+func (s *s3Fs) Chtimes(name string, atime, mtime time.Time) error { return nil }
+func (s *s3Fs) Chown(name string, uid, gid int) error             { return nil }
+
+// s3WriteFile is an in-memory afero.File that uploads its full contents to
+// S3 when closed.
+type s3WriteFile struct {
+	afero.File
+	fs   *s3Fs
+	mem  afero.Fs
+	name string
+}
+
+func (f *s3WriteFile) Close() error {
+	if err := f.File.Close(); err != nil {
+		return err
+	}
+
+	data, err := afero.ReadFile(f.mem, f.name)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.fs.client.PutObject(context.Background(), &s3.PutObjectInput{
+		Bucket: aws.String(f.fs.bucket),
+		Key:    aws.String(f.fs.key(f.name)),
+		Body:   strings.NewReader(string(data)),
+	})
+	if err != nil {
+		return fmt.Errorf("s3fs: failed to put s3://%s/%s: %w", f.fs.bucket, f.fs.key(f.name), err)
+	}
+	return nil
+}
+
+// s3FileInfo is a minimal os.FileInfo backed by an S3 HeadObject response.
+type s3FileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+}
+
+func (fi s3FileInfo) Name() string       { return fi.name }
+func (fi s3FileInfo) Size() int64        { return fi.size }
+func (fi s3FileInfo) Mode() os.FileMode  { return 0o644 }
+func (fi s3FileInfo) ModTime() time.Time { return fi.modTime }
+func (fi s3FileInfo) IsDir() bool        { return false }
+func (fi s3FileInfo) Sys() any           { return nil }
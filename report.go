@@ -0,0 +1,86 @@
+package railgen
+
+// OperationReport is the reportable view of an OperationInfo, with Status
+// rendered as its string form so it serializes cleanly to JSON.
+type OperationReport struct {
+	ID       string          `json:"id"`
+	Method   string          `json:"method"`
+	Path     string          `json:"path"`
+	Tag      string          `json:"tag"`
+	Status   string          `json:"status"`
+	Subtests []SubtestStatus `json:"subtests,omitempty"`
+	Blame    *BlameInfo      `json:"blame,omitempty"`
+}
+
+// Summary aggregates implementation percentages across a whole report.
+type Summary struct {
+	FilesTotal          int     `json:"filesTotal"`
+	FilesImplemented    int     `json:"filesImplemented"`
+	FilesPercent        float64 `json:"filesPercent"`
+	SubtestsTotal       int     `json:"subtestsTotal"`
+	SubtestsImplemented int     `json:"subtestsImplemented"`
+	SubtestsPercent     float64 `json:"subtestsPercent"`
+}
+
+// Report is the structured result of listing operations, suitable for
+// either text rendering or direct JSON encoding.
+type Report struct {
+	Operations []OperationReport `json:"operations"`
+	Summary    Summary           `json:"summary"`
+}
+
+// BuildReport turns raw operation info into a Report, optionally dropping
+// fully implemented operations when unimplementedOnly is set. The summary
+// always reflects the full, unfiltered set of operations.
+func BuildReport(operations []OperationInfo, unimplementedOnly bool) Report {
+	report := Report{}
+	for _, op := range operations {
+		if unimplementedOnly && op.Status == StatusImplemented {
+			continue
+		}
+		report.Operations = append(report.Operations, OperationReport{
+			ID:       op.ID,
+			Method:   op.Method,
+			Path:     op.Path,
+			Tag:      op.Tag,
+			Status:   op.Status.String(),
+			Subtests: op.Subtests,
+			Blame:    op.Blame,
+		})
+	}
+
+	filesImplemented, subtestsTotal, subtestsImplemented := Aggregate(operations)
+	report.Summary = Summary{
+		FilesTotal:          len(operations),
+		FilesImplemented:    filesImplemented,
+		FilesPercent:        Percent(filesImplemented, len(operations)),
+		SubtestsTotal:       subtestsTotal,
+		SubtestsImplemented: subtestsImplemented,
+		SubtestsPercent:     Percent(subtestsImplemented, subtestsTotal),
+	}
+	return report
+}
+
+// Aggregate counts implemented files and subtests across operations.
+func Aggregate(operations []OperationInfo) (filesImplemented, subtestsTotal, subtestsImplemented int) {
+	for _, op := range operations {
+		if op.Status == StatusImplemented {
+			filesImplemented++
+		}
+		for _, st := range op.Subtests {
+			subtestsTotal++
+			if st.Implemented {
+				subtestsImplemented++
+			}
+		}
+	}
+	return filesImplemented, subtestsTotal, subtestsImplemented
+}
+
+// Percent returns n/total as a percentage, or 0 when total is 0.
+func Percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total) * 100
+}
@@ -0,0 +1,59 @@
+package railgen
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+	"text/template"
+
+	"github.com/spf13/afero"
+)
+
+//go:embed templates/default.go.tmpl
+var defaultTemplateFS embed.FS
+
+const defaultTemplatePath = "templates/default.go.tmpl"
+
+// DefaultTemplate returns the built-in test template source, for `railgen
+// template dump` to write out as a starting point for customization.
+func DefaultTemplate() ([]byte, error) {
+	return defaultTemplateFS.ReadFile(defaultTemplatePath)
+}
+
+// loadTemplate resolves which template to render a test file with, in
+// priority order: a per-tag override from TemplateDir (named
+// "<packageName>.go.tmpl"), the single file at TemplatePath, or the
+// embedded default.
+func (g *Generator) loadTemplate(packageName string) (*template.Template, error) {
+	if g.TemplateDir != "" {
+		candidate := filepath.Join(g.TemplateDir, packageName+".go.tmpl")
+		if exists, err := afero.Exists(g.Fs, candidate); err != nil {
+			return nil, fmt.Errorf("failed to stat template %s: %w", candidate, err)
+		} else if exists {
+			return parseTemplateFile(g.Fs, candidate)
+		}
+	}
+
+	if g.TemplatePath != "" {
+		return parseTemplateFile(g.Fs, g.TemplatePath)
+	}
+
+	src, err := DefaultTemplate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read embedded default template: %w", err)
+	}
+	return template.New("default").Parse(string(src))
+}
+
+func parseTemplateFile(fsys afero.Fs, path string) (*template.Template, error) {
+	data, err := afero.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template %s: %w", path, err)
+	}
+
+	tmpl, err := template.New(filepath.Base(path)).Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %s: %w", path, err)
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,112 @@
+package railgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// schemaTypeName renders an OpenAPI schema's type list as a plain string,
+// e.g. "string" or "string,null" for a nullable string, instead of Go's
+// pointer-to-slice representation of *openapi3.Types.
+func schemaTypeName(types *openapi3.Types) string {
+	if types == nil {
+		return ""
+	}
+	return strings.Join(*types, ",")
+}
+
+// buildParameters flattens an operation's parameters into the simple shape
+// the template sees: in, name, required and a best-effort schema type.
+func buildParameters(op *openapi3.Operation) []Parameter {
+	var params []Parameter
+	for _, ref := range op.Parameters {
+		if ref.Value == nil {
+			continue
+		}
+
+		p := ref.Value
+		schema := ""
+		if p.Schema != nil && p.Schema.Value != nil {
+			schema = schemaTypeName(p.Schema.Value.Type)
+		}
+
+		params = append(params, Parameter{
+			In:       p.In,
+			Name:     p.Name,
+			Required: p.Required,
+			Schema:   schema,
+		})
+	}
+	return params
+}
+
+// buildRequestBody lists the request body's media types along with any
+// example value declared for each, sorted by content type.
+func buildRequestBody(op *openapi3.Operation) []RequestBodyMediaType {
+	if op.RequestBody == nil || op.RequestBody.Value == nil {
+		return nil
+	}
+
+	var medias []RequestBodyMediaType
+	for contentType, media := range op.RequestBody.Value.Content {
+		example := ""
+		if media.Example != nil {
+			example = fmt.Sprintf("%v", media.Example)
+		}
+		medias = append(medias, RequestBodyMediaType{
+			ContentType: contentType,
+			Example:     example,
+		})
+	}
+
+	sort.Slice(medias, func(i, j int) bool { return medias[i].ContentType < medias[j].ContentType })
+	return medias
+}
+
+// buildSecurity collects the names of every security scheme required by
+// the operation, sorted and deduplicated.
+func buildSecurity(op *openapi3.Operation) []string {
+	if op.Security == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var names []string
+	for _, requirement := range *op.Security {
+		for name := range requirement {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// buildResponseContents lists a response's media types along with a
+// best-effort schema type description for each, sorted by content type.
+func buildResponseContents(response *openapi3.ResponseRef) []ResponseContent {
+	if response == nil || response.Value == nil {
+		return nil
+	}
+
+	var contents []ResponseContent
+	for contentType, media := range response.Value.Content {
+		schema := ""
+		if media.Schema != nil && media.Schema.Value != nil {
+			schema = schemaTypeName(media.Schema.Value.Type)
+		}
+		contents = append(contents, ResponseContent{
+			ContentType: contentType,
+			Schema:      schema,
+		})
+	}
+
+	sort.Slice(contents, func(i, j int) bool { return contents[i].ContentType < contents[j].ContentType })
+	return contents
+}
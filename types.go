@@ -0,0 +1,95 @@
+package railgen
+
+// TestData is the data passed to the test template to render a single
+// generated `*_test.go` file. The built-in template only uses a handful of
+// these fields; the rest exist so custom templates (see Generator.
+// TemplatePath/TemplateDir) can render request/response detail the default
+// template doesn't need.
+type TestData struct {
+	PackageName    string
+	TestName       string
+	Method         string
+	Path           string
+	Summary        string
+	Description    string
+	Responses      []ResponseCode
+	CustomComments []string
+	Parameters     []Parameter
+	RequestBody    []RequestBodyMediaType
+	Security       []string
+}
+
+// ResponseCode is a single OpenAPI response entry rendered as a `t.Run`
+// subtest in the generated test.
+type ResponseCode struct {
+	Code        string
+	Description string
+	Method      string
+	Path        string
+	Contents    []ResponseContent
+}
+
+// Parameter is a single OpenAPI operation parameter.
+type Parameter struct {
+	In       string
+	Name     string
+	Required bool
+	Schema   string
+}
+
+// RequestBodyMediaType is one content-type entry of an operation's request
+// body, along with any example value declared for it.
+type RequestBodyMediaType struct {
+	ContentType string
+	Example     string
+}
+
+// ResponseContent is one content-type entry of a response, along with a
+// best-effort description of its schema.
+type ResponseContent struct {
+	ContentType string
+	Schema      string
+}
+
+// OperationInfo describes one OpenAPI operation and the implementation
+// status of its generated test file, as determined by ListOperations.
+type OperationInfo struct {
+	ID          string
+	Method      string
+	Path        string
+	Tag         string
+	Summary     string
+	Description string
+	Status      ImplStatus
+	Subtests    []SubtestStatus
+	Blame       *BlameInfo
+}
+
+// ImplStatus classifies how far along a generated test file is, based on
+// whether the `t.Skip` calls the template writes have been removed.
+type ImplStatus int
+
+const (
+	StatusUnimplemented ImplStatus = iota
+	StatusPartial
+	StatusImplemented
+)
+
+func (s ImplStatus) String() string {
+	switch s {
+	case StatusImplemented:
+		return "implemented"
+	case StatusPartial:
+		return "partial"
+	default:
+		return "unimplemented"
+	}
+}
+
+// SubtestStatus is the implementation status of a single `t.Run` subtest,
+// keyed by the `<code>_<method>_<path>` name the template writes.
+type SubtestStatus struct {
+	Name        string `json:"name"`
+	Code        string `json:"code"`
+	Implemented bool   `json:"implemented"`
+}
@@ -0,0 +1,75 @@
+package railgen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+func sanitizePackageName(tag string) string {
+	if tag == "" {
+		return "api"
+	}
+	result := strings.ToLower(tag)
+	result = strings.ReplaceAll(result, "-", "_")
+	result = strings.ReplaceAll(result, " ", "_")
+	return result
+}
+
+func loadCustomComment(fsys afero.Fs, commentsFile string) ([]string, error) {
+	if commentsFile == "" {
+		return nil, nil
+	}
+
+	content, err := afero.ReadFile(fsys, commentsFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read comments file: %w", err)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	return lines, nil
+}
+
+func toPascalCase(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	parts := strings.FieldsFunc(s, func(c rune) bool {
+		return c == '_' || c == '-' || c == ' '
+	})
+
+	for i, part := range parts {
+		if len(part) > 0 {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+func toSnakeCase(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	var result strings.Builder
+	for i, r := range s {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			result.WriteRune('_')
+		}
+		result.WriteRune(r)
+	}
+
+	return strings.ToLower(result.String())
+}
+
+func copyFile(fsys afero.Fs, src, dst string) error {
+	data, err := afero.ReadFile(fsys, src)
+	if err != nil {
+		return err
+	}
+
+	return afero.WriteFile(fsys, dst, data, 0o644)
+}